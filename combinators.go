@@ -0,0 +1,119 @@
+package async
+
+import "errors"
+
+// Settled - Outcome of a Future observed via NewFutureAllSettled, capturing whatever
+// happened to it so nothing is dropped.
+type Settled[T any] struct {
+	Value T
+	Err   error
+	Panic any
+}
+
+/* CONSTRUCTORS */
+
+// Then - Chain fn onto f's successful result. An error or panic from f is forwarded to the
+// returned Future unchanged, without fn being invoked.
+func Then[T any, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	future := Future[U]{done: make(chan struct{})}
+	go func() {
+		defer panicPacker(&future)
+		val, err := f.getResult()
+		if err != nil {
+			future.setError(err)
+			return
+		}
+		future.setResult(fn(val))
+	}()
+	return &future
+}
+
+// Map - Pure transform of f's successful result. An error or panic from f is forwarded to
+// the returned Future unchanged, without fn being invoked.
+func Map[T any, U any](f *Future[T], fn func(T) U) *Future[U] {
+	return Then(f, func(v T) (U, error) { return fn(v), nil })
+}
+
+// NewFutureAll - Resolve with the values of all futs, in order, once every one of them has
+// succeeded, or with the first error/panic encountered. The remaining futures are left
+// running; cancel them via their own ctx if that's not wanted.
+func NewFutureAll[T any](futs ...*Future[T]) *Future[[]T] {
+	future := Future[[]T]{done: make(chan struct{})}
+	go func() {
+		defer panicPacker(&future)
+		results := make([]T, len(futs))
+		for i, fut := range futs {
+			val, err := fut.getResult()
+			if err != nil {
+				future.setError(err)
+				return
+			}
+			results[i] = val
+		}
+		future.setResult(results, nil)
+	}()
+	return &future
+}
+
+// NewFutureAllSettled - Resolve once every one of futs has settled, carrying each one's
+// value, error, or panic so nothing is dropped.
+func NewFutureAllSettled[T any](futs ...*Future[T]) *Future[[]Settled[T]] {
+	future := Future[[]Settled[T]]{done: make(chan struct{})}
+	go func() {
+		results := make([]Settled[T], len(futs))
+		for i, fut := range futs {
+			results[i] = settle(fut)
+		}
+		future.setResult(results, nil)
+	}()
+	return &future
+}
+
+// NewFutureAny - Resolve with the first successful result among futs, only failing once
+// every one of them has failed (with the last error or panic encountered).
+func NewFutureAny[T any](futs ...*Future[T]) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go func() {
+		if len(futs) == 0 {
+			future.setError(errors.New("async: NewFutureAny called with no futures"))
+			return
+		}
+
+		results := make(chan Settled[T], len(futs))
+		for _, fut := range futs {
+			go func(source *Future[T]) {
+				results <- settle(source)
+			}(fut)
+		}
+
+		var last Settled[T]
+		for range futs {
+			s := <-results
+			if s.Err == nil && s.Panic == nil {
+				future.setResult(s.Value, nil)
+				return
+			}
+			last = s
+		}
+		if last.Panic != nil {
+			future.setPanic(last.Panic)
+			return
+		}
+		future.setError(last.Err)
+	}()
+	return &future
+}
+
+func settle[T any](fut *Future[T]) (s Settled[T]) {
+	defer func() {
+		// fut.getResult() only ever re-panics an already-resolved *PanicError (see
+		// panicPacker); the handlers fired once for it at fut's origin already, so
+		// normalizePanic here is just for a consistent Settled.Panic type, not delivery.
+		if r := recover(); r != nil {
+			pe, _ := normalizePanic(r)
+			s.Panic = pe
+		}
+	}()
+	s.Value, s.Err = fut.getResult()
+	return
+}
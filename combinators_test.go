@@ -0,0 +1,99 @@
+package async
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestThenChainsOnSuccess(t *testing.T) {
+	f := NewFuture(func() (int, error) { return 21, nil })
+	g := Then(f, func(n int) (string, error) { return "twice is " + strconv.Itoa(n*2), nil })
+	res := assertNoError[string](t)(g.Await())
+	if res != "twice is 42" {
+		t.Errorf("Unexpected result: %s", res)
+	}
+}
+
+func TestThenForwardsUpstreamError(t *testing.T) {
+	sentinel := errors.New("boom")
+	f := NewFuture(func() (int, error) { return 0, sentinel })
+	called := false
+	g := Then(f, func(n int) (int, error) { called = true; return n, nil })
+	_, err := g.Await()
+	if !errors.Is(err, sentinel) || called {
+		t.Errorf("Expected forwarded error without invoking fn, got err=%v called=%v", err, called)
+	}
+}
+
+func TestMapTransformsResult(t *testing.T) {
+	f := NewFuture(func() (int, error) { return 10, nil })
+	g := Map(f, func(n int) int { return n + 1 })
+	res := assertNoError[int](t)(g.Await())
+	if res != 11 {
+		t.Errorf("Expected 11, got %d", res)
+	}
+}
+
+func TestNewFutureAllResolvesInOrder(t *testing.T) {
+	f1 := NewFuture1(asyncAddOne, 1)
+	f2 := NewFuture1(asyncAddOne, 2)
+	f3 := NewFuture1(asyncAddOne, 3)
+	res := assertNoError[[]int](t)(NewFutureAll(f1, f2, f3).Await())
+	if len(res) != 3 || res[0] != 2 || res[1] != 3 || res[2] != 4 {
+		t.Errorf("Unexpected results: %v", res)
+	}
+}
+
+func TestNewFutureAllFailsOnFirstError(t *testing.T) {
+	f1 := NewFuture1(asyncAddOne, 1)
+	f2 := NewFuture1(asyncAddOne, -1)
+	_, err := NewFutureAll(f1, f2).Await()
+	if err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestNewFutureAllSettledCapturesEverything(t *testing.T) {
+	ok := NewFuture(func() (int, error) { return 1, nil })
+	failed := NewFuture(func() (int, error) { return 0, errors.New("nope") })
+	results := assertNoError[[]Settled[int]](t)(NewFutureAllSettled(ok, failed).Await())
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 settled results, got %d", len(results))
+	}
+	if results[0].Value != 1 || results[0].Err != nil {
+		t.Errorf("Unexpected first result: %+v", results[0])
+	}
+	if results[1].Err == nil {
+		t.Errorf("Expected second result to carry its error: %+v", results[1])
+	}
+}
+
+func TestNewFutureAnySucceedsOnFirstSuccess(t *testing.T) {
+	slow := NewFuture(func() (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	})
+	fast := NewFuture(func() (int, error) { return 2, nil })
+	res := assertNoError[int](t)(NewFutureAny(slow, fast).Await())
+	if res != 2 {
+		t.Errorf("Expected fast result 2, got %d", res)
+	}
+}
+
+func TestNewFutureAnyFailsOnlyWhenAllFail(t *testing.T) {
+	f1 := NewFuture(func() (int, error) { return 0, errors.New("one") })
+	f2 := NewFuture(func() (int, error) { return 0, errors.New("two") })
+	_, err := NewFutureAny(f1, f2).Await()
+	if err == nil {
+		t.Error("Expected an error when every input fails")
+	}
+}
+
+func TestNewFutureAnyFailsWithNoFutures(t *testing.T) {
+	_, err := NewFutureAny[int]().Await()
+	if err == nil {
+		t.Error("Expected an error for the vacuous case of no futures supplied")
+	}
+}
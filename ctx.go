@@ -0,0 +1,179 @@
+package async
+
+import (
+	"context"
+)
+
+/* CONSTRUCTORS */
+
+// NewFutureCtx - Execute fn as a goroutine bound to ctx.
+//
+//	If ctx is cancelled before fn returns, Await unblocks immediately with ctx.Err();
+//	fn keeps running in the background and its eventual value/panic is recorded
+//	internally rather than delivered, since the future has already resolved.
+func NewFutureCtx[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx(&future, ctx, fn)
+	return &future
+}
+
+// NewFutureCtx1 - Variation for function taking 1 argument in addition to ctx
+func NewFutureCtx1[T any, A any](ctx context.Context, fn func(ctx context.Context, arg1 A) (T, error), arg1 A) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx1(&future, ctx, fn, arg1)
+	return &future
+}
+
+// NewFutureCtx2 - Variation for function taking 2 arguments in addition to ctx
+func NewFutureCtx2[T any, A any, B any](ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B) (T, error), arg1 A, arg2 B) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx2(&future, ctx, fn, arg1, arg2)
+	return &future
+}
+
+// NewFutureCtx3 - Variation for function taking 3 arguments in addition to ctx
+func NewFutureCtx3[T any, A any, B any, C any](ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C) (T, error), arg1 A, arg2 B, arg3 C) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx3(&future, ctx, fn, arg1, arg2, arg3)
+	return &future
+}
+
+// NewFutureCtx4 - Variation for function taking 4 arguments in addition to ctx
+func NewFutureCtx4[T any, A any, B any, C any, D any](ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D) (T, error), arg1 A, arg2 B, arg3 C, arg4 D) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx4(&future, ctx, fn, arg1, arg2, arg3, arg4)
+	return &future
+}
+
+// NewFutureCtx5 - Variation for function taking 5 arguments in addition to ctx
+func NewFutureCtx5[T any, A any, B any, C any, D any, E any](ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D, arg5 E) (T, error), arg1 A, arg2 B, arg3 C, arg4 D, arg5 E) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx5(&future, ctx, fn, arg1, arg2, arg3, arg4, arg5)
+	return &future
+}
+
+// NewFutureCtx6 - Variation for function taking 6 arguments in addition to ctx
+func NewFutureCtx6[T any, A any, B any, C any, D any, E any, F any](ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D, arg5 E, arg6 F) (T, error), arg1 A, arg2 B, arg3 C, arg4 D, arg5 E, arg6 F) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncCtx6(&future, ctx, fn, arg1, arg2, arg3, arg4, arg5, arg6)
+	return &future
+}
+
+// NewCancellableFuture - Like NewFutureCtx, but derives and owns its own context, handing
+// back the CancelFunc alongside the Future so the caller can cancel it from the outside.
+func NewCancellableFuture[T any](fn func(ctx context.Context) (T, error)) (*Future[T], context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return NewFutureCtx(ctx, fn), cancel
+}
+
+/* GETTERS */
+
+// Done - Read-only view of the internal done channel, so callers can select on multiple
+// futures without spawning a race goroutine for every wait.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+/* Private helpers */
+
+// lateOutcome - fn's value/error or panic, captured after ctx had already resolved its
+// Future via cancellation. Stashed on Future.lateOutcome instead of being dropped.
+type lateOutcome[T any] struct {
+	value T
+	err   error
+	panic any
+}
+
+// watchCtx - Resolves fut with ctx.Err() the moment ctx is cancelled, unless fut has
+// already resolved by then. Exits once fut is done either way.
+func watchCtx[T any](fut *Future[T], ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			fut.setError(ctx.Err())
+		case <-fut.done:
+		}
+	}()
+}
+
+// deliverOrRecord - Resolves fut with (val, err) unless ctx cancellation already beat fn
+// to it, in which case the outcome is recorded on fut.lateOutcome instead of discarded.
+func deliverOrRecord[T any](fut *Future[T], val T, err error) {
+	if fut.trySetResult(val, err) {
+		return
+	}
+	fut.mu.Lock()
+	fut.lateOutcome = &lateOutcome[T]{value: val, err: err}
+	fut.mu.Unlock()
+}
+
+// deliverOrRecordPanic - Recovers a panic from fn, notifies the panic handlers (unless it's
+// an upstream panic being relayed), and resolves fut with it unless ctx cancellation already
+// beat fn to it, in which case the panic is recorded on fut.lateOutcome instead of discarded.
+func deliverOrRecordPanic[T any](fut *Future[T]) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	pe, alreadyPacked := normalizePanic(r)
+	if !alreadyPacked {
+		notifyPanicHandlers(fut, pe)
+	}
+
+	if fut.trySetPanic(pe) {
+		return
+	}
+	fut.mu.Lock()
+	fut.lateOutcome = &lateOutcome[T]{panic: pe}
+	fut.mu.Unlock()
+}
+
+func runAsyncCtx[T any](fut *Future[T], ctx context.Context, fn func(ctx context.Context) (T, error)) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx1[T any, A any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A) (T, error), arg1 A) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx2[T any, A any, B any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B) (T, error), arg1 A, arg2 B) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1, arg2)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx3[T any, A any, B any, C any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C) (T, error), arg1 A, arg2 B, arg3 C) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1, arg2, arg3)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx4[T any, A any, B any, C any, D any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D) (T, error), arg1 A, arg2 B, arg3 C, arg4 D) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1, arg2, arg3, arg4)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx5[T any, A any, B any, C any, D any, E any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D, arg5 E) (T, error), arg1 A, arg2 B, arg3 C, arg4 D, arg5 E) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1, arg2, arg3, arg4, arg5)
+	deliverOrRecord(fut, val, err)
+}
+
+func runAsyncCtx6[T any, A any, B any, C any, D any, E any, F any](fut *Future[T], ctx context.Context, fn func(ctx context.Context, arg1 A, arg2 B, arg3 C, arg4 D, arg5 E, arg6 F) (T, error), arg1 A, arg2 B, arg3 C, arg4 D, arg5 E, arg6 F) {
+	watchCtx(fut, ctx)
+	defer deliverOrRecordPanic(fut)
+	val, err := fn(ctx, arg1, arg2, arg3, arg4, arg5, arg6)
+	deliverOrRecord(fut, val, err)
+}
@@ -0,0 +1,118 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewFutureCtxResolvesNormally(t *testing.T) {
+	fut := NewFutureCtx(context.Background(), func(ctx context.Context) (int, error) {
+		return 7, nil
+	})
+	res := assertNoError[int](t)(fut.Await())
+	if res != 7 {
+		t.Errorf("Expected 7, got %d", res)
+	}
+}
+
+func TestNewFutureCtxCancelUnblocksAwaitImmediately(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := NewFutureCtx(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		time.Sleep(30 * time.Millisecond) // still "working" after cancellation
+		return 99, nil
+	})
+
+	cancel()
+	start := time.Now()
+	_, err := fut.Await()
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if elapsed > 10*time.Millisecond {
+		t.Errorf("Expected Await to unblock immediately on cancel, took %s", elapsed)
+	}
+}
+
+func TestNewFutureCtxRecordsLateResultInsteadOfDiscardingIt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := NewFutureCtx(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		time.Sleep(20 * time.Millisecond)
+		return 42, nil
+	})
+
+	cancel()
+	_, err := fut.Await()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	time.Sleep(40 * time.Millisecond) // let the late fn finish
+	fut.mu.Lock()
+	late := fut.lateOutcome
+	fut.mu.Unlock()
+	if late == nil || late.value != 42 {
+		t.Errorf("Expected the late result to be recorded, got %+v", late)
+	}
+}
+
+func TestNewFutureCtxRecordsLatePanicInsteadOfDiscardingIt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := NewFutureCtx(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		time.Sleep(20 * time.Millisecond)
+		panic("too late")
+	})
+
+	cancel()
+	_, err := fut.Await()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	time.Sleep(40 * time.Millisecond) // let the late fn finish
+	fut.mu.Lock()
+	late := fut.lateOutcome
+	fut.mu.Unlock()
+	pe, ok := late.panic.(*PanicError)
+	if late == nil || !ok || pe.Value != "too late" {
+		t.Errorf("Expected the late panic to be recorded, got %+v", late)
+	}
+}
+
+func TestNewCancellableFutureOwnsItsContext(t *testing.T) {
+	fut, cancel := NewCancellableFuture(func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	cancel()
+	_, err := fut.Await()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFutureDoneSelectable(t *testing.T) {
+	f1 := NewFuture(func() (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	})
+	f2 := NewFuture(func() (int, error) { return 2, nil })
+
+	select {
+	case <-f1.Done():
+		t.Error("Did not expect f1 to be done first")
+	case <-f2.Done():
+		res := assertNoError[int](t)(f2.Await())
+		if res != 2 {
+			t.Errorf("Expected 2, got %d", res)
+		}
+	case <-time.After(time.Second):
+		t.Error("Timed out waiting for either future")
+	}
+}
@@ -16,6 +16,13 @@ type Future[T any] struct {
 
 	done   chan struct{}
 	isDone bool
+
+	panicHandler func(pe *PanicError)
+
+	// lateOutcome - Set by the ctx-native constructors (see ctx.go) when fn finishes
+	// after ctx already resolved this Future via cancellation, so that outcome isn't
+	// simply discarded even though it can no longer be delivered through Await.
+	lateOutcome *lateOutcome[T]
 }
 
 /* CONSTRUCTORS */
@@ -113,36 +120,52 @@ func (f *Future[T]) AwaitForDone() error {
 /* Private helpers - most importantly for tight control over mutex scoping */
 
 func (f *Future[T]) setResult(r T, e error) {
+	f.trySetResult(r, e)
+}
+
+func (f *Future[T]) setError(e error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
 	if !f.isDone {
-		f.value, f.err = r, e
+		f.err = e
 		f.isDone = true
 		close(f.done)
 	}
 }
 
-func (f *Future[T]) setError(e error) {
+func (f *Future[T]) setPanic(p any) {
+	f.trySetPanic(p)
+}
+
+// trySetResult - Like setResult, but reports whether this call was the one that resolved
+// the future, so a caller can fall back to recording an outcome that lost the race.
+func (f *Future[T]) trySetResult(r T, e error) bool {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	if !f.isDone {
-		f.err = e
-		f.isDone = true
-		close(f.done)
+	if f.isDone {
+		return false
 	}
+	f.value, f.err = r, e
+	f.isDone = true
+	close(f.done)
+	return true
 }
 
-func (f *Future[T]) setPanic(p any) {
+// trySetPanic - Like setPanic, but reports whether this call was the one that resolved
+// the future, so a caller can fall back to recording an outcome that lost the race.
+func (f *Future[T]) trySetPanic(p any) bool {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	if !f.isDone {
-		f.panicErr = p
-		f.isDone = true
-		close(f.done)
+	if f.isDone {
+		return false
 	}
+	f.panicErr = p
+	f.isDone = true
+	close(f.done)
+	return true
 }
 
 func (f *Future[T]) hasResult() bool {
@@ -165,12 +188,6 @@ func (f *Future[T]) getResult() (T, error) {
 	return val, err
 }
 
-func panicPacker[T any](f *Future[T]) {
-	if r := recover(); r != nil {
-		f.setPanic(r)
-	}
-}
-
 func runAsync[T any](fut *Future[T], fn func() (T, error)) {
 	defer panicPacker(fut)
 	fut.setResult(fn())
@@ -0,0 +1,79 @@
+package async
+
+import (
+	"sync"
+)
+
+// Group - Deduplicates concurrent work by key: callers arriving while work for a key is
+// already in flight all receive the same *Future[T], and fn is invoked only once. The
+// entry is removed as soon as it resolves, so a later Do call for the same key re-runs fn.
+//
+//	The zero value is ready to use.
+type Group[K comparable, T any] struct {
+	mu       sync.Mutex
+	inFlight map[K]*Future[T]
+}
+
+/* GETTERS */
+
+// Do - Execute fn for key, or return the in-flight Future if one is already running for key.
+func (g *Group[K, T]) Do(key K, fn func() (T, error)) *Future[T] {
+	g.mu.Lock()
+	if g.inFlight == nil {
+		g.inFlight = make(map[K]*Future[T])
+	}
+	if future, ok := g.inFlight[key]; ok {
+		g.mu.Unlock()
+		return future
+	}
+
+	future := &Future[T]{done: make(chan struct{})}
+	g.inFlight[key] = future
+	g.mu.Unlock()
+
+	go g.run(key, future, fn)
+	return future
+}
+
+// DoChan - Variant of Do that always hands back a fresh *Future[T] for immediate
+// awaiting, while still deduplicating the underlying work against any execution already
+// in flight for key.
+func (g *Group[K, T]) DoChan(key K, fn func() (T, error)) *Future[T] {
+	shared := g.Do(key, fn)
+	relay := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer panicPacker(relay)
+		relay.setResult(shared.getResult())
+	}()
+	return relay
+}
+
+// Forget - Drop the in-flight entry for key, if any, so the next Do call re-executes fn.
+//
+//	Futures already handed out to earlier callers are left to resolve normally.
+func (g *Group[K, T]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.inFlight, key)
+}
+
+/* Private helpers */
+
+func (g *Group[K, T]) run(key K, future *Future[T], fn func() (T, error)) {
+	defer panicPacker(future)
+	// Remove the entry before resolving, so a Do call made after Await returns cannot
+	// be handed this already-resolved Future.
+	val, err := func() (T, error) {
+		defer g.cleanup(key, future)
+		return fn()
+	}()
+	future.setResult(val, err)
+}
+
+func (g *Group[K, T]) cleanup(key K, future *Future[T]) {
+	g.mu.Lock()
+	if g.inFlight[key] == future {
+		delete(g.inFlight, key)
+	}
+	g.mu.Unlock()
+}
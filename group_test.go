@@ -0,0 +1,90 @@
+package async
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroupDoDeduplicatesConcurrentCallers(t *testing.T) {
+	var calls int32
+	var g Group[string, int]
+
+	fn := func() (int, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return 42, nil
+	}
+
+	f1 := g.Do("key", fn)
+	f2 := g.Do("key", fn)
+
+	r1 := assertNoError[int](t)(f1.Await())
+	r2 := assertNoError[int](t)(f2.Await())
+
+	if r1 != 42 || r2 != 42 {
+		t.Error("Incorrect results")
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("Expected fn to run once, ran %d times", calls)
+	}
+}
+
+func TestGroupDoReRunsAfterResolution(t *testing.T) {
+	var calls int32
+	var g Group[string, int]
+
+	fn := func() (int, error) {
+		n := atomic.AddInt32(&calls, 1)
+		return int(n), nil
+	}
+
+	r1 := assertNoError[int](t)(g.Do("key", fn).Await())
+	r2 := assertNoError[int](t)(g.Do("key", fn).Await())
+
+	if r1 != 1 || r2 != 2 {
+		t.Errorf("Expected fn to re-run once resolved, got %d then %d", r1, r2)
+	}
+}
+
+func TestGroupForget(t *testing.T) {
+	var calls int32
+	var g Group[string, int]
+
+	fn := func() (int, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	}
+
+	g.Do("key", fn)
+	g.Forget("key")
+	assertNoError[int](t)(g.Do("key", fn).Await())
+
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("Expected fn to run twice after Forget, ran %d times", calls)
+	}
+}
+
+func TestGroupDoPropagatesPanicToAllSubscribers(t *testing.T) {
+	var g Group[string, int]
+	fn := func() (int, error) {
+		panic("boom")
+	}
+
+	f1 := g.DoChan("key", fn)
+	f2 := g.DoChan("key", fn)
+
+	assertPanics(t, func() { _, _ = f1.Await() })
+	assertPanics(t, func() { _, _ = f2.Await() })
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic, got none")
+		}
+	}()
+	fn()
+}
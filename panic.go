@@ -0,0 +1,97 @@
+package async
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// PanicError - Wraps a value recovered from a panicking Future goroutine together with the
+// stack trace captured at the point of recovery, so the original stack survives long enough
+// for whoever inspects the panic from Await to see where it actually happened.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("async: panic recovered: %v\n%s", e.Value, e.Stack)
+}
+
+var globalPanicHandler atomic.Pointer[func(pe *PanicError)]
+
+// SetPanicHandler - Install a package-level hook invoked with every panic recovered from a
+// Future's goroutine, before the Future resolves. Pass nil to remove it.
+//
+//	Lets applications plug in logging/metrics/Sentry without wrapping every fn themselves;
+//	otherwise a panic in a Future nobody Awaits is completely invisible.
+func SetPanicHandler(handler func(pe *PanicError)) {
+	if handler == nil {
+		globalPanicHandler.Store(nil)
+		return
+	}
+	globalPanicHandler.Store(&handler)
+}
+
+// WithPanicHandler - Install a per-future hook invoked, in addition to any package-level
+// handler set via SetPanicHandler, when this Future's goroutine panics. Returns f so it can
+// be chained onto a constructor call.
+//
+//	The Future's goroutine is already running by the time a constructor returns, so a
+//	handler installed this way can be missed if fn panics before the chained call runs.
+//	Use NewFutureWithPanicHandler for a race-free guarantee.
+func (f *Future[T]) WithPanicHandler(handler func(pe *PanicError)) *Future[T] {
+	f.mu.Lock()
+	f.panicHandler = handler
+	f.mu.Unlock()
+	return f
+}
+
+// NewFutureWithPanicHandler - Like NewFuture, but installs handler before the goroutine
+// starts, so it cannot miss a panic from a fast-failing fn the way a chained
+// WithPanicHandler call can.
+func NewFutureWithPanicHandler[T any](fn func() (T, error), handler func(pe *PanicError)) *Future[T] {
+	future := Future[T]{done: make(chan struct{}), panicHandler: handler}
+	go runAsync(&future, fn)
+	return &future
+}
+
+// normalizePanic - Turns a recovered value into a *PanicError, wrapping a fresh panic with
+// a freshly captured stack, or passing an already-wrapped one (relayed from an upstream
+// Future via getResult) through unchanged.
+func normalizePanic(r any) (pe *PanicError, alreadyPacked bool) {
+	if pe, ok := r.(*PanicError); ok {
+		return pe, true
+	}
+	return &PanicError{Value: r, Stack: debug.Stack()}, false
+}
+
+// notifyPanicHandlers - Invokes f's per-future handler, if any, then the package-level one.
+func notifyPanicHandlers[T any](f *Future[T], pe *PanicError) {
+	f.mu.Lock()
+	handler := f.panicHandler
+	f.mu.Unlock()
+	if handler != nil {
+		handler(pe)
+	}
+	if global := globalPanicHandler.Load(); global != nil {
+		(*global)(pe)
+	}
+}
+
+func panicPacker[T any](f *Future[T]) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	// A relay/combinator goroutine re-panics an upstream Future's already-resolved
+	// *PanicError (see getResult); forward it as-is instead of wrapping it again, and
+	// skip the handlers too — they already fired once for it at the origin.
+	pe, alreadyPacked := normalizePanic(r)
+	if !alreadyPacked {
+		notifyPanicHandlers(f, pe)
+	}
+
+	f.setPanic(pe)
+}
@@ -0,0 +1,150 @@
+package async
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAwaitRepanicsWithPanicError(t *testing.T) {
+	fut := NewFuture(func() (int, error) {
+		panic("kaboom")
+	})
+
+	defer func() {
+		r := recover()
+		pe, ok := r.(*PanicError)
+		if !ok {
+			t.Fatalf("Expected recover to yield *PanicError, got %T", r)
+		}
+		if pe.Value != "kaboom" {
+			t.Errorf("Expected wrapped value 'kaboom', got %v", pe.Value)
+		}
+		if len(pe.Stack) == 0 {
+			t.Error("Expected a captured stack trace")
+		}
+		if !strings.Contains(pe.Error(), "kaboom") {
+			t.Errorf("Expected Error() to mention the panic value, got %s", pe.Error())
+		}
+	}()
+	_, _ = fut.Await()
+}
+
+func TestNewFutureWithPanicHandlerIsInvoked(t *testing.T) {
+	var mu sync.Mutex
+	var captured *PanicError
+
+	fut := NewFutureWithPanicHandler(func() (int, error) {
+		panic("local")
+	}, func(pe *PanicError) {
+		mu.Lock()
+		captured = pe
+		mu.Unlock()
+	})
+
+	assertPanics(t, func() { _, _ = fut.Await() })
+
+	mu.Lock()
+	defer mu.Unlock()
+	if captured == nil || captured.Value != "local" {
+		t.Errorf("Expected per-future handler to capture the panic, got %+v", captured)
+	}
+}
+
+func TestSetPanicHandlerIsInvoked(t *testing.T) {
+	var mu sync.Mutex
+	var captured *PanicError
+
+	SetPanicHandler(func(pe *PanicError) {
+		mu.Lock()
+		captured = pe
+		mu.Unlock()
+	})
+	defer SetPanicHandler(nil)
+
+	fut := NewFuture(func() (int, error) {
+		panic("global")
+	})
+	assertPanics(t, func() { _, _ = fut.Await() })
+
+	mu.Lock()
+	defer mu.Unlock()
+	if captured == nil || captured.Value != "global" {
+		t.Errorf("Expected package-level handler to capture the panic, got %+v", captured)
+	}
+}
+
+func TestRelayedPanicIsNotDoubleWrapped(t *testing.T) {
+	origin := NewFuture(func() (int, error) {
+		panic("kaboom")
+	})
+
+	assertUnwrappedPanic := func(t *testing.T, fn func()) {
+		t.Helper()
+		defer func() {
+			r := recover()
+			pe, ok := r.(*PanicError)
+			if !ok {
+				t.Fatalf("Expected recover to yield *PanicError, got %T", r)
+			}
+			if pe.Value != "kaboom" {
+				t.Errorf("Expected unwrapped value 'kaboom', got %v (%T)", pe.Value, pe.Value)
+			}
+		}()
+		fn()
+	}
+
+	assertUnwrappedPanic(t, func() {
+		_, _ = Then(origin, func(n int) (int, error) { return n, nil }).Await()
+	})
+
+	var g Group[string, int]
+	assertUnwrappedPanic(t, func() {
+		_, _ = g.DoChan("key", func() (int, error) { panic("kaboom") }).Await()
+	})
+
+	assertUnwrappedPanic(t, func() {
+		_, _ = NewFutureRace(NewFuture(func() (int, error) { panic("kaboom") })).Await()
+	})
+}
+
+func TestPanicHandlerFiresOnceAcrossRelays(t *testing.T) {
+	var count int32
+	SetPanicHandler(func(pe *PanicError) { atomic.AddInt32(&count, 1) })
+	defer SetPanicHandler(nil)
+
+	var g Group[string, int]
+	fn := func() (int, error) { panic("boom") }
+	f1, f2 := g.DoChan("key", fn), g.DoChan("key", fn)
+	assertPanics(t, func() { _, _ = f1.Await() })
+	assertPanics(t, func() { _, _ = f2.Await() })
+
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("Expected the panic handler to fire once, fired %d times", got)
+	}
+}
+
+func TestSettledCombinatorsDoNotInvokeHandlerForRelayedPanics(t *testing.T) {
+	var count int32
+	SetPanicHandler(func(pe *PanicError) { atomic.AddInt32(&count, 1) })
+	defer SetPanicHandler(nil)
+
+	origin := NewFuture(func() (int, error) { panic("boom") })
+	assertPanics(t, func() { _, _ = origin.Await() })
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Fatalf("Expected the origin panic to fire the handler once, fired %d times", got)
+	}
+
+	results := assertNoError[[]Settled[int]](t)(NewFutureAllSettled(origin, origin).Await())
+	for _, r := range results {
+		if r.Panic == nil {
+			t.Error("Expected settled result to carry the panic")
+		}
+	}
+	assertPanics(t, func() { _, _ = NewFutureAny(origin, origin).Await() })
+
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("Expected NewFutureAllSettled/NewFutureAny not to re-invoke the handler, count is now %d", got)
+	}
+}
@@ -0,0 +1,111 @@
+package async
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy - Controls how NewFutureRetry repeats a failing operation.
+type RetryPolicy struct {
+	// InitialDelay - Delay before the second attempt.
+	InitialDelay time.Duration
+	// MaxDelay - Upper bound the delay is clamped to after backoff and jitter.
+	MaxDelay time.Duration
+	// Multiplier - Backoff growth factor applied to the delay after each attempt (e.g. 2.0 for exponential).
+	Multiplier float64
+	// JitterFraction - Fraction in [0,1] of the delay to randomize, spreading thundering herds.
+	//
+	//	The delay is multiplied by 1 + rand.Float64()*JitterFraction - JitterFraction/2.
+	JitterFraction float64
+	// MaxAttempts - Maximum number of calls to fn. 0 means unlimited.
+	MaxAttempts int
+	// MaxElapsed - Wall-clock budget for the whole retry loop, starting from the first attempt. 0 means unlimited.
+	MaxElapsed time.Duration
+	// Retryable - Classifies whether an error should be retried. nil means all errors are retryable.
+	Retryable func(error) bool
+}
+
+// RetryError - Wraps the last error returned by fn once NewFutureRetry gives up.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("retry: giving up after %d attempt(s): %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// NewFutureRetry - Execute fn as a goroutine, repeatedly, until it returns nil error, ctx
+// is cancelled, or policy's stop condition fires. The final result (or the last error,
+// wrapped in a *RetryError with the attempt count) is delivered through the returned Future.
+// If ctx is cancelled while waiting between attempts, the Future resolves with ctx.Err()
+// itself rather than a *RetryError.
+func NewFutureRetry[T any](ctx context.Context, policy RetryPolicy, fn func(ctx context.Context, attempt int) (T, error)) *Future[T] {
+	future := Future[T]{done: make(chan struct{})}
+	go runAsyncRetry(&future, ctx, policy, fn)
+	return &future
+}
+
+func runAsyncRetry[T any](fut *Future[T], ctx context.Context, policy RetryPolicy, fn func(ctx context.Context, attempt int) (T, error)) {
+	defer panicPacker(fut)
+
+	start := time.Now()
+	delay := policy.InitialDelay
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		val, err := fn(ctx, attempt)
+		if err == nil {
+			fut.setResult(val, nil)
+			return
+		}
+		lastErr = err
+
+		if policy.Retryable != nil && !policy.Retryable(err) {
+			fut.setError(&RetryError{Attempts: attempt, Err: lastErr})
+			return
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			fut.setError(&RetryError{Attempts: attempt, Err: lastErr})
+			return
+		}
+		if policy.MaxElapsed > 0 && time.Since(start) >= policy.MaxElapsed {
+			fut.setError(&RetryError{Attempts: attempt, Err: lastErr})
+			return
+		}
+
+		wait := withJitter(delay, policy.JitterFraction)
+		if policy.MaxDelay > 0 && wait > policy.MaxDelay {
+			wait = policy.MaxDelay
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			fut.setError(ctx.Err())
+			return
+		case <-timer.C:
+		}
+
+		if policy.Multiplier > 0 {
+			delay = time.Duration(float64(delay) * policy.Multiplier)
+		}
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
+
+func withJitter(delay time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 || delay <= 0 {
+		return delay
+	}
+	factor := 1 + rand.Float64()*fraction - fraction/2
+	return time.Duration(float64(delay) * factor)
+}
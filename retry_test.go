@@ -0,0 +1,76 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetrySucceedsAfterTransientFailures(t *testing.T) {
+	attempts := 0
+	fn := func(ctx context.Context, attempt int) (int, error) {
+		attempts = attempt
+		if attempt < 3 {
+			return 0, errors.New("not yet")
+		}
+		return 99, nil
+	}
+	policy := RetryPolicy{InitialDelay: time.Millisecond, Multiplier: 2.0}
+	res := assertNoError[int](t)(NewFutureRetry(context.Background(), policy, fn).Await())
+	if res != 99 || attempts != 3 {
+		t.Errorf("Expected 99 after 3 attempts, got %d after %d", res, attempts)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	fn := func(ctx context.Context, attempt int) (int, error) {
+		attempts = attempt
+		return 0, errors.New("always fails")
+	}
+	policy := RetryPolicy{InitialDelay: time.Millisecond, MaxAttempts: 3}
+	_, err := NewFutureRetry(context.Background(), policy, fn).Await()
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Errorf("Expected RetryError with 3 attempts, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryAbortsOnNonRetryableError(t *testing.T) {
+	sentinel := errors.New("fatal")
+	attempts := 0
+	fn := func(ctx context.Context, attempt int) (int, error) {
+		attempts = attempt
+		return 0, sentinel
+	}
+	policy := RetryPolicy{
+		InitialDelay: time.Millisecond,
+		MaxAttempts:  5,
+		Retryable:    func(err error) bool { return !errors.Is(err, sentinel) },
+	}
+	_, err := NewFutureRetry(context.Background(), policy, fn).Await()
+	if err == nil || attempts != 1 {
+		t.Errorf("Expected immediate abort on first attempt, got err=%v attempts=%d", err, attempts)
+	}
+}
+
+func TestRetryCancelledByContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := func(ctx context.Context, attempt int) (int, error) {
+		return 0, errors.New("still failing")
+	}
+	policy := RetryPolicy{InitialDelay: 50 * time.Millisecond}
+	fut := NewFutureRetry(ctx, policy, fn)
+	cancel()
+	_, err := fut.Await()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}